@@ -267,6 +267,10 @@ type SnapshotCRInput struct {
 	Labels map[string]string `json:"labels"`
 }
 
+type SnapshotCRListInput struct {
+	LabelSelector string `json:"labelSelector"`
+}
+
 type BackupInput struct {
 	Name string `json:"name"`
 }
@@ -531,6 +535,24 @@ type SnapshotCRListOutput struct {
 	Type string       `json:"type"`
 }
 
+// VolumeImpact struct is used for the node impact action
+type VolumeImpact struct {
+	client.Resource
+	VolumeName                  string `json:"volumeName"`
+	State                       string `json:"state"`
+	Robustness                  string `json:"robustness"`
+	AttachedToNode              bool   `json:"attachedToNode"`
+	ReplicasOnNode              int    `json:"replicasOnNode"`
+	HealthyReplicasOnOtherNodes int    `json:"healthyReplicasOnOtherNodes"`
+	CanFailover                 bool   `json:"canFailover"`
+	Reason                      string `json:"reason"`
+}
+
+type VolumeImpactListOutput struct {
+	Data []VolumeImpact `json:"data"`
+	Type string         `json:"type"`
+}
+
 func NewSchema() *client.Schemas {
 	schemas := &client.Schemas{}
 
@@ -541,6 +563,7 @@ func NewSchema() *client.Schemas {
 	schemas.AddType("detachInput", DetachInput{})
 	schemas.AddType("snapshotInput", SnapshotInput{})
 	schemas.AddType("snapshotCRInput", SnapshotCRInput{})
+	schemas.AddType("snapshotCRListInput", SnapshotCRListInput{})
 	schemas.AddType("backupTarget", BackupTarget{})
 	schemas.AddType("backup", Backup{})
 	schemas.AddType("backupInput", BackupInput{})
@@ -615,6 +638,8 @@ func NewSchema() *client.Schemas {
 	systemBackupSchema(schemas.AddType("systemBackup", SystemBackup{}))
 	systemRestoreSchema(schemas.AddType("systemRestore", SystemRestore{}))
 	snapshotCRListOutputSchema(schemas.AddType("snapshotCRListOutput", SnapshotCRListOutput{}))
+	schemas.AddType("volumeImpact", VolumeImpact{})
+	volumeImpactListOutputSchema(schemas.AddType("volumeImpactListOutput", VolumeImpactListOutput{}))
 
 	return schemas
 }
@@ -628,6 +653,9 @@ func nodeSchema(node *client.Schema) {
 			Input:  "diskUpdateInput",
 			Output: "node",
 		},
+		"impact": {
+			Output: "volumeImpactListOutput",
+		},
 	}
 
 	allowScheduling := node.ResourceFields["allowScheduling"]
@@ -867,6 +895,10 @@ func volumeSchema(volume *client.Schema) {
 		"snapshotCRList": {
 			Output: "snapshotCRListOutput",
 		},
+		"snapshotCRListByLabel": {
+			Input:  "snapshotCRListInput",
+			Output: "snapshotCRListOutput",
+		},
 		"snapshotCRDelete": {
 			Input:  "snapshotCRInput",
 			Output: "empty",
@@ -1159,6 +1191,12 @@ func snapshotCRListOutputSchema(snapshotList *client.Schema) {
 	snapshotList.ResourceFields["data"] = data
 }
 
+func volumeImpactListOutputSchema(volumeImpactList *client.Schema) {
+	data := volumeImpactList.ResourceFields["data"]
+	data.Type = "array[volumeImpact]"
+	volumeImpactList.ResourceFields["data"] = data
+}
+
 func attachmentSchema(attachment *client.Schema) {
 	conditions := attachment.ResourceFields["conditions"]
 	conditions.Type = "array[longhornCondition]"
@@ -1457,6 +1495,7 @@ func toVolumeResource(v *longhorn.Volume, ves []*longhorn.Engine, vrs []*longhor
 		actions["snapshotCRCreate"] = struct{}{}
 		actions["snapshotCRGet"] = struct{}{}
 		actions["snapshotCRList"] = struct{}{}
+		actions["snapshotCRListByLabel"] = struct{}{}
 		actions["snapshotCRDelete"] = struct{}{}
 		actions["snapshotBackup"] = struct{}{}
 
@@ -1568,6 +1607,28 @@ func toSnapshotCRCollection(snapCRs map[string]*longhorn.Snapshot) *client.Gener
 	return &client.GenericCollection{Data: data, Collection: client.Collection{ResourceType: "snapshotCR"}}
 }
 
+func toVolumeImpactCollection(impacts []*manager.VolumeNodeImpact) *client.GenericCollection {
+	data := []interface{}{}
+
+	for _, impact := range impacts {
+		data = append(data, &VolumeImpact{
+			Resource: client.Resource{
+				Id:   impact.VolumeName,
+				Type: "volumeImpact",
+			},
+			VolumeName:                  impact.VolumeName,
+			State:                       string(impact.State),
+			Robustness:                  string(impact.Robustness),
+			AttachedToNode:              impact.AttachedToNode,
+			ReplicasOnNode:              impact.ReplicasOnNode,
+			HealthyReplicasOnOtherNodes: impact.HealthyReplicasOnOtherNodes,
+			CanFailover:                 impact.CanFailover,
+			Reason:                      impact.Reason,
+		})
+	}
+	return &client.GenericCollection{Data: data, Collection: client.Collection{ResourceType: "volumeImpact"}}
+}
+
 func toSnapshotResource(s *longhorn.SnapshotInfo, checksum string) *Snapshot {
 	if s == nil {
 		return nil
@@ -1873,6 +1934,7 @@ func toNodeResource(node *longhorn.Node, address string, apiContext *api.ApiCont
 
 	n.Actions = map[string]string{
 		"diskUpdate": apiContext.UrlBuilder.ActionLink(n.Resource, "diskUpdate"),
+		"impact":     apiContext.UrlBuilder.ActionLink(n.Resource, "impact"),
 	}
 
 	return n
@@ -119,6 +119,18 @@ func (s *Server) DiskUpdate(rw http.ResponseWriter, req *http.Request) error {
 	return nil
 }
 
+func (s *Server) NodeImpact(rw http.ResponseWriter, req *http.Request) error {
+	apiContext := api.GetApiContext(req)
+	id := mux.Vars(req)["name"]
+
+	impacts, err := s.m.GetNodeImpact(id)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get impact of node %v", id)
+	}
+	apiContext.Write(toVolumeImpactCollection(impacts))
+	return nil
+}
+
 func (s *Server) NodeDelete(rw http.ResponseWriter, req *http.Request) error {
 	id := mux.Vars(req)["name"]
 	if err := s.m.DeleteNode(id); err != nil {
@@ -99,10 +99,11 @@ func NewRouter(s *Server) *mux.Router {
 		"snapshotRevert": s.fwd.Handler(s.fwd.HandleProxyRequestByNodeID, s.fwd.GetHTTPAddressByNodeID(OwnerIDFromVolume(s.m)), s.SnapshotRevert),
 		"snapshotBackup": s.fwd.Handler(s.fwd.HandleProxyRequestByNodeID, s.fwd.GetHTTPAddressByNodeID(OwnerIDFromVolume(s.m)), s.SnapshotBackup),
 
-		"snapshotCRCreate": s.SnapshotCRCreate,
-		"snapshotCRList":   s.SnapshotCRList,
-		"snapshotCRGet":    s.SnapshotCRGet,
-		"snapshotCRDelete": s.SnapshotCRDelete,
+		"snapshotCRCreate":      s.SnapshotCRCreate,
+		"snapshotCRList":        s.SnapshotCRList,
+		"snapshotCRListByLabel": s.SnapshotCRListByLabel,
+		"snapshotCRGet":         s.SnapshotCRGet,
+		"snapshotCRDelete":      s.SnapshotCRDelete,
 
 		"pvCreate":  s.PVCreate,
 		"pvcCreate": s.PVCCreate,
@@ -134,6 +135,7 @@ func NewRouter(s *Server) *mux.Router {
 	r.Methods("DELETE").Path("/v1/nodes/{name}").Handler(f(schemas, s.NodeDelete))
 	nodeActions := map[string]func(http.ResponseWriter, *http.Request) error{
 		"diskUpdate": s.DiskUpdate,
+		"impact":     s.NodeImpact,
 	}
 	for name, action := range nodeActions {
 		r.Methods("POST").Path("/v1/nodes/{name}").Queries("action", name).Handler(f(schemas, action))
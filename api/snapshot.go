@@ -258,6 +258,33 @@ func (s *Server) SnapshotCRList(w http.ResponseWriter, req *http.Request) (err e
 	return nil
 }
 
+func (s *Server) SnapshotCRListByLabel(w http.ResponseWriter, req *http.Request) (err error) {
+	defer func() {
+		err = errors.Wrap(err, "failed to list snapshot CRs by label")
+	}()
+
+	var input SnapshotCRListInput
+
+	apiContext := api.GetApiContext(req)
+	if err := apiContext.Read(&input); err != nil {
+		return err
+	}
+
+	if input.LabelSelector == "" {
+		return fmt.Errorf("empty label selector")
+	}
+
+	volName := mux.Vars(req)["name"]
+
+	snapCRsRO, err := s.m.ListSnapshotsCRByLabelSelector(volName, input.LabelSelector)
+	if err != nil {
+		return err
+	}
+	apiContext.Write(toSnapshotCRCollection(snapCRsRO))
+
+	return nil
+}
+
 func (s *Server) SnapshotCRGet(w http.ResponseWriter, req *http.Request) (err error) {
 	defer func() {
 		err = errors.Wrap(err, "failed to get snapshot CR")
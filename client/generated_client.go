@@ -9,6 +9,7 @@ type RancherClient struct {
 	DetachInput                            DetachInputOperations
 	SnapshotInput                          SnapshotInputOperations
 	SnapshotCRInput                        SnapshotCRInputOperations
+	SnapshotCRListInput                    SnapshotCRListInputOperations
 	BackupTarget                           BackupTargetOperations
 	Backup                                 BackupOperations
 	BackupInput                            BackupInputOperations
@@ -72,6 +73,8 @@ type RancherClient struct {
 	SystemBackup                           SystemBackupOperations
 	SystemRestore                          SystemRestoreOperations
 	SnapshotCRListOutput                   SnapshotCRListOutputOperations
+	VolumeImpact                           VolumeImpactOperations
+	VolumeImpactListOutput                 VolumeImpactListOutputOperations
 }
 
 func constructClient(rancherBaseClient *RancherBaseClientImpl) *RancherClient {
@@ -85,6 +88,7 @@ func constructClient(rancherBaseClient *RancherBaseClientImpl) *RancherClient {
 	client.DetachInput = newDetachInputClient(client)
 	client.SnapshotInput = newSnapshotInputClient(client)
 	client.SnapshotCRInput = newSnapshotCRInputClient(client)
+	client.SnapshotCRListInput = newSnapshotCRListInputClient(client)
 	client.BackupTarget = newBackupTargetClient(client)
 	client.Backup = newBackupClient(client)
 	client.BackupInput = newBackupInputClient(client)
@@ -148,6 +152,8 @@ func constructClient(rancherBaseClient *RancherBaseClientImpl) *RancherClient {
 	client.SystemBackup = newSystemBackupClient(client)
 	client.SystemRestore = newSystemRestoreClient(client)
 	client.SnapshotCRListOutput = newSnapshotCRListOutputClient(client)
+	client.VolumeImpact = newVolumeImpactClient(client)
+	client.VolumeImpactListOutput = newVolumeImpactListOutputClient(client)
 
 	return client
 }
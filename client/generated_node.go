@@ -46,6 +46,8 @@ type NodeOperations interface {
 	Delete(container *Node) error
 
 	ActionDiskUpdate(*Node, *DiskUpdateInput) (*Node, error)
+
+	ActionImpact(*Node) (*VolumeImpactListOutput, error)
 }
 
 func newNodeClient(rancherClient *RancherClient) *NodeClient {
@@ -106,3 +108,12 @@ func (c *NodeClient) ActionDiskUpdate(resource *Node, input *DiskUpdateInput) (*
 
 	return resp, err
 }
+
+func (c *NodeClient) ActionImpact(resource *Node) (*VolumeImpactListOutput, error) {
+
+	resp := &VolumeImpactListOutput{}
+
+	err := c.rancherClient.doAction(NODE_TYPE, "impact", &resource.Resource, nil, resp)
+
+	return resp, err
+}
@@ -0,0 +1,79 @@
+package client
+
+const (
+	SNAPSHOT_CRLIST_INPUT_TYPE = "snapshotCRListInput"
+)
+
+type SnapshotCRListInput struct {
+	Resource `yaml:"-"`
+
+	LabelSelector string `json:"labelSelector,omitempty" yaml:"label_selector,omitempty"`
+}
+
+type SnapshotCRListInputCollection struct {
+	Collection
+	Data   []SnapshotCRListInput `json:"data,omitempty"`
+	client *SnapshotCRListInputClient
+}
+
+type SnapshotCRListInputClient struct {
+	rancherClient *RancherClient
+}
+
+type SnapshotCRListInputOperations interface {
+	List(opts *ListOpts) (*SnapshotCRListInputCollection, error)
+	Create(opts *SnapshotCRListInput) (*SnapshotCRListInput, error)
+	Update(existing *SnapshotCRListInput, updates interface{}) (*SnapshotCRListInput, error)
+	ById(id string) (*SnapshotCRListInput, error)
+	Delete(container *SnapshotCRListInput) error
+}
+
+func newSnapshotCRListInputClient(rancherClient *RancherClient) *SnapshotCRListInputClient {
+	return &SnapshotCRListInputClient{
+		rancherClient: rancherClient,
+	}
+}
+
+func (c *SnapshotCRListInputClient) Create(container *SnapshotCRListInput) (*SnapshotCRListInput, error) {
+	resp := &SnapshotCRListInput{}
+	err := c.rancherClient.doCreate(SNAPSHOT_CRLIST_INPUT_TYPE, container, resp)
+	return resp, err
+}
+
+func (c *SnapshotCRListInputClient) Update(existing *SnapshotCRListInput, updates interface{}) (*SnapshotCRListInput, error) {
+	resp := &SnapshotCRListInput{}
+	err := c.rancherClient.doUpdate(SNAPSHOT_CRLIST_INPUT_TYPE, &existing.Resource, updates, resp)
+	return resp, err
+}
+
+func (c *SnapshotCRListInputClient) List(opts *ListOpts) (*SnapshotCRListInputCollection, error) {
+	resp := &SnapshotCRListInputCollection{}
+	err := c.rancherClient.doList(SNAPSHOT_CRLIST_INPUT_TYPE, opts, resp)
+	resp.client = c
+	return resp, err
+}
+
+func (cc *SnapshotCRListInputCollection) Next() (*SnapshotCRListInputCollection, error) {
+	if cc != nil && cc.Pagination != nil && cc.Pagination.Next != "" {
+		resp := &SnapshotCRListInputCollection{}
+		err := cc.client.rancherClient.doNext(cc.Pagination.Next, resp)
+		resp.client = cc.client
+		return resp, err
+	}
+	return nil, nil
+}
+
+func (c *SnapshotCRListInputClient) ById(id string) (*SnapshotCRListInput, error) {
+	resp := &SnapshotCRListInput{}
+	err := c.rancherClient.doById(SNAPSHOT_CRLIST_INPUT_TYPE, id, resp)
+	if apiError, ok := err.(*ApiError); ok {
+		if apiError.StatusCode == 404 {
+			return nil, nil
+		}
+	}
+	return resp, err
+}
+
+func (c *SnapshotCRListInputClient) Delete(container *SnapshotCRListInput) error {
+	return c.rancherClient.doResourceDelete(SNAPSHOT_CRLIST_INPUT_TYPE, &container.Resource)
+}
@@ -161,6 +161,8 @@ type VolumeOperations interface {
 
 	ActionSnapshotCRList(*Volume) (*SnapshotCRListOutput, error)
 
+	ActionSnapshotCRListByLabel(*Volume, *SnapshotCRListInput) (*SnapshotCRListOutput, error)
+
 	ActionSnapshotCreate(*Volume, *SnapshotInput) (*Snapshot, error)
 
 	ActionSnapshotDelete(*Volume, *SnapshotInput) (*Volume, error)
@@ -381,6 +383,15 @@ func (c *VolumeClient) ActionSnapshotCRList(resource *Volume) (*SnapshotCRListOu
 	return resp, err
 }
 
+func (c *VolumeClient) ActionSnapshotCRListByLabel(resource *Volume, input *SnapshotCRListInput) (*SnapshotCRListOutput, error) {
+
+	resp := &SnapshotCRListOutput{}
+
+	err := c.rancherClient.doAction(VOLUME_TYPE, "snapshotCRListByLabel", &resource.Resource, input, resp)
+
+	return resp, err
+}
+
 func (c *VolumeClient) ActionSnapshotCreate(resource *Volume, input *SnapshotInput) (*Snapshot, error) {
 
 	resp := &Snapshot{}
@@ -0,0 +1,93 @@
+package client
+
+const (
+	VOLUME_IMPACT_TYPE = "volumeImpact"
+)
+
+type VolumeImpact struct {
+	Resource `yaml:"-"`
+
+	AttachedToNode bool `json:"attachedToNode,omitempty" yaml:"attached_to_node,omitempty"`
+
+	CanFailover bool `json:"canFailover,omitempty" yaml:"can_failover,omitempty"`
+
+	HealthyReplicasOnOtherNodes int64 `json:"healthyReplicasOnOtherNodes,omitempty" yaml:"healthy_replicas_on_other_nodes,omitempty"`
+
+	Reason string `json:"reason,omitempty" yaml:"reason,omitempty"`
+
+	ReplicasOnNode int64 `json:"replicasOnNode,omitempty" yaml:"replicas_on_node,omitempty"`
+
+	Robustness string `json:"robustness,omitempty" yaml:"robustness,omitempty"`
+
+	State string `json:"state,omitempty" yaml:"state,omitempty"`
+
+	VolumeName string `json:"volumeName,omitempty" yaml:"volume_name,omitempty"`
+}
+
+type VolumeImpactCollection struct {
+	Collection
+	Data   []VolumeImpact `json:"data,omitempty"`
+	client *VolumeImpactClient
+}
+
+type VolumeImpactClient struct {
+	rancherClient *RancherClient
+}
+
+type VolumeImpactOperations interface {
+	List(opts *ListOpts) (*VolumeImpactCollection, error)
+	Create(opts *VolumeImpact) (*VolumeImpact, error)
+	Update(existing *VolumeImpact, updates interface{}) (*VolumeImpact, error)
+	ById(id string) (*VolumeImpact, error)
+	Delete(container *VolumeImpact) error
+}
+
+func newVolumeImpactClient(rancherClient *RancherClient) *VolumeImpactClient {
+	return &VolumeImpactClient{
+		rancherClient: rancherClient,
+	}
+}
+
+func (c *VolumeImpactClient) Create(container *VolumeImpact) (*VolumeImpact, error) {
+	resp := &VolumeImpact{}
+	err := c.rancherClient.doCreate(VOLUME_IMPACT_TYPE, container, resp)
+	return resp, err
+}
+
+func (c *VolumeImpactClient) Update(existing *VolumeImpact, updates interface{}) (*VolumeImpact, error) {
+	resp := &VolumeImpact{}
+	err := c.rancherClient.doUpdate(VOLUME_IMPACT_TYPE, &existing.Resource, updates, resp)
+	return resp, err
+}
+
+func (c *VolumeImpactClient) List(opts *ListOpts) (*VolumeImpactCollection, error) {
+	resp := &VolumeImpactCollection{}
+	err := c.rancherClient.doList(VOLUME_IMPACT_TYPE, opts, resp)
+	resp.client = c
+	return resp, err
+}
+
+func (cc *VolumeImpactCollection) Next() (*VolumeImpactCollection, error) {
+	if cc != nil && cc.Pagination != nil && cc.Pagination.Next != "" {
+		resp := &VolumeImpactCollection{}
+		err := cc.client.rancherClient.doNext(cc.Pagination.Next, resp)
+		resp.client = cc.client
+		return resp, err
+	}
+	return nil, nil
+}
+
+func (c *VolumeImpactClient) ById(id string) (*VolumeImpact, error) {
+	resp := &VolumeImpact{}
+	err := c.rancherClient.doById(VOLUME_IMPACT_TYPE, id, resp)
+	if apiError, ok := err.(*ApiError); ok {
+		if apiError.StatusCode == 404 {
+			return nil, nil
+		}
+	}
+	return resp, err
+}
+
+func (c *VolumeImpactClient) Delete(container *VolumeImpact) error {
+	return c.rancherClient.doResourceDelete(VOLUME_IMPACT_TYPE, &container.Resource)
+}
@@ -0,0 +1,79 @@
+package client
+
+const (
+	VOLUME_IMPACT_LIST_OUTPUT_TYPE = "volumeImpactListOutput"
+)
+
+type VolumeImpactListOutput struct {
+	Resource `yaml:"-"`
+
+	Data []VolumeImpact `json:"data,omitempty" yaml:"data,omitempty"`
+}
+
+type VolumeImpactListOutputCollection struct {
+	Collection
+	Data   []VolumeImpactListOutput `json:"data,omitempty"`
+	client *VolumeImpactListOutputClient
+}
+
+type VolumeImpactListOutputClient struct {
+	rancherClient *RancherClient
+}
+
+type VolumeImpactListOutputOperations interface {
+	List(opts *ListOpts) (*VolumeImpactListOutputCollection, error)
+	Create(opts *VolumeImpactListOutput) (*VolumeImpactListOutput, error)
+	Update(existing *VolumeImpactListOutput, updates interface{}) (*VolumeImpactListOutput, error)
+	ById(id string) (*VolumeImpactListOutput, error)
+	Delete(container *VolumeImpactListOutput) error
+}
+
+func newVolumeImpactListOutputClient(rancherClient *RancherClient) *VolumeImpactListOutputClient {
+	return &VolumeImpactListOutputClient{
+		rancherClient: rancherClient,
+	}
+}
+
+func (c *VolumeImpactListOutputClient) Create(container *VolumeImpactListOutput) (*VolumeImpactListOutput, error) {
+	resp := &VolumeImpactListOutput{}
+	err := c.rancherClient.doCreate(VOLUME_IMPACT_LIST_OUTPUT_TYPE, container, resp)
+	return resp, err
+}
+
+func (c *VolumeImpactListOutputClient) Update(existing *VolumeImpactListOutput, updates interface{}) (*VolumeImpactListOutput, error) {
+	resp := &VolumeImpactListOutput{}
+	err := c.rancherClient.doUpdate(VOLUME_IMPACT_LIST_OUTPUT_TYPE, &existing.Resource, updates, resp)
+	return resp, err
+}
+
+func (c *VolumeImpactListOutputClient) List(opts *ListOpts) (*VolumeImpactListOutputCollection, error) {
+	resp := &VolumeImpactListOutputCollection{}
+	err := c.rancherClient.doList(VOLUME_IMPACT_LIST_OUTPUT_TYPE, opts, resp)
+	resp.client = c
+	return resp, err
+}
+
+func (cc *VolumeImpactListOutputCollection) Next() (*VolumeImpactListOutputCollection, error) {
+	if cc != nil && cc.Pagination != nil && cc.Pagination.Next != "" {
+		resp := &VolumeImpactListOutputCollection{}
+		err := cc.client.rancherClient.doNext(cc.Pagination.Next, resp)
+		resp.client = cc.client
+		return resp, err
+	}
+	return nil, nil
+}
+
+func (c *VolumeImpactListOutputClient) ById(id string) (*VolumeImpactListOutput, error) {
+	resp := &VolumeImpactListOutput{}
+	err := c.rancherClient.doById(VOLUME_IMPACT_LIST_OUTPUT_TYPE, id, resp)
+	if apiError, ok := err.(*ApiError); ok {
+		if apiError.StatusCode == 404 {
+			return nil, nil
+		}
+	}
+	return resp, err
+}
+
+func (c *VolumeImpactListOutputClient) Delete(container *VolumeImpactListOutput) error {
+	return c.rancherClient.doResourceDelete(VOLUME_IMPACT_LIST_OUTPUT_TYPE, &container.Resource)
+}
@@ -342,6 +342,12 @@ func (sc *SnapshotController) reconcile(snapshotName string) (err error) {
 			return sc.handleAttachmentTicketDeletion(snapshot)
 		}
 
+		if isSnapshotDeletionUnsupported(snapshot, engine) {
+			// The engine will never support the deletion, so do not keep
+			// the volume attached for it
+			return sc.handleAttachmentTicketDeletion(snapshot)
+		}
+
 		if err := sc.handleAttachmentTicketCreation(snapshot); err != nil {
 			return err
 		}
@@ -351,6 +357,12 @@ func (sc *SnapshotController) reconcile(snapshotName string) (err error) {
 		}
 		// Delete the snapshot from engine process
 		if err := sc.handleSnapshotDeletion(snapshot, engine); err != nil {
+			if engineapi.IsUnsupportedOperationError(err) {
+				// Retrying will not help, so record the error and stop requeuing
+				sc.logger.WithError(err).Warnf("Cannot delete snapshot %v", snapshot.Name)
+				snapshot.Status.Error = errors.Cause(err).Error()
+				return sc.handleAttachmentTicketDeletion(snapshot)
+			}
 			return err
 		}
 
@@ -626,6 +638,22 @@ func (sc *SnapshotController) handleSnapshotDeletion(snapshot *longhorn.Snapshot
 	return nil
 }
 
+// isSnapshotDeletionUnsupported returns true if the snapshot deletion already
+// failed because the engine does not support it.
+func isSnapshotDeletionUnsupported(snapshot *longhorn.Snapshot, engine *longhorn.Engine) bool {
+	for _, operation := range []string{engineapi.OperationSnapshotDelete, engineapi.OperationSnapshotPurge} {
+		unsupportedErr := &engineapi.UnsupportedOperationError{
+			Operation:          operation,
+			EngineName:         engine.Name,
+			BackendStoreDriver: engine.Spec.BackendStoreDriver,
+		}
+		if snapshot.Status.Error == unsupportedErr.Error() {
+			return true
+		}
+	}
+	return false
+}
+
 func (sc *SnapshotController) isResponsibleFor(snap *longhorn.Snapshot) (bool, error) {
 	var err error
 	defer func() {
@@ -1,8 +1,26 @@
 package controller
 
 import (
+	"context"
 	"fmt"
 	"testing"
+
+	"github.com/sirupsen/logrus"
+	. "gopkg.in/check.v1"
+
+	apiextensionsfake "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/fake"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/longhorn/longhorn-manager/datastore"
+	"github.com/longhorn/longhorn-manager/engineapi"
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+	lhfake "github.com/longhorn/longhorn-manager/k8s/pkg/client/clientset/versioned/fake"
+	lhinformerfactory "github.com/longhorn/longhorn-manager/k8s/pkg/client/informers/externalversions"
+	"github.com/longhorn/longhorn-manager/util"
 )
 
 func TestShouldUpdateObject(t *testing.T) {
@@ -28,3 +46,86 @@ func TestShouldUpdateObject(t *testing.T) {
 		t.Fatal("reconcileErr1 must be non-updatable error")
 	}
 }
+
+func (s *TestSuite) TestSnapshotDeletionUnsupportedOperation(c *C) {
+	kubeClient := fake.NewSimpleClientset()
+	lhClient := lhfake.NewSimpleClientset()
+	extensionsClient := apiextensionsfake.NewSimpleClientset()
+	kubeInformerFactory := informers.NewSharedInformerFactory(kubeClient, 0)
+	lhInformerFactory := lhinformerfactory.NewSharedInformerFactory(lhClient, 0)
+	ds := datastore.NewDataStore(lhInformerFactory, lhClient, kubeInformerFactory, kubeClient, extensionsClient, TestNamespace)
+	logger := logrus.StandardLogger()
+
+	volumeIndexer := lhInformerFactory.Longhorn().V1beta2().Volumes().Informer().GetIndexer()
+	engineIndexer := lhInformerFactory.Longhorn().V1beta2().Engines().Informer().GetIndexer()
+	snapshotIndexer := lhInformerFactory.Longhorn().V1beta2().Snapshots().Informer().GetIndexer()
+	volumeAttachmentIndexer := lhInformerFactory.Longhorn().V1beta2().VolumeAttachments().Informer().GetIndexer()
+
+	sc := NewSnapshotController(logger, ds, scheme.Scheme, kubeClient, TestNamespace, TestOwnerID1, nil, util.NewAtomicCounter())
+	sc.eventRecorder = record.NewFakeRecorder(100)
+
+	vol := newVolume(TestVolumeName, 2)
+	vol.Spec.BackendStoreDriver = longhorn.BackendStoreDriverTypeV2
+
+	engine := newEngineForVolume(vol)
+	engine.Spec.BackendStoreDriver = longhorn.BackendStoreDriverTypeV2
+	engine.Status.CurrentState = longhorn.InstanceStateRunning
+
+	// The deletion has already failed once, so the controller must neither
+	// call the engine again nor keep the volume attached for it.
+	unsupportedErr := &engineapi.UnsupportedOperationError{
+		Operation:          engineapi.OperationSnapshotDelete,
+		EngineName:         engine.Name,
+		BackendStoreDriver: engine.Spec.BackendStoreDriver,
+	}
+	now := metav1.Now()
+	snapshot := &longhorn.Snapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "test-snapshot",
+			Namespace:         TestNamespace,
+			Finalizers:        []string{longhorn.SchemeGroupVersion.Group},
+			DeletionTimestamp: &now,
+		},
+		Spec: longhorn.SnapshotSpec{
+			Volume: vol.Name,
+		},
+		Status: longhorn.SnapshotStatus{
+			Error: unsupportedErr.Error(),
+		},
+	}
+
+	attachmentTicketID := longhorn.GetAttachmentTicketID(longhorn.AttacherTypeSnapshotController, snapshot.Name)
+	va := newVolumeAttachment(vol.Name)
+	va.Spec.AttachmentTickets = map[string]*longhorn.AttachmentTicket{}
+	createOrUpdateAttachmentTicket(va, attachmentTicketID, TestOwnerID1, longhorn.AnyValue, longhorn.AttacherTypeSnapshotController)
+
+	// Seed the data.
+	// Need to put it into both fakeclientset and Indexer because
+	// the fake client doesn't work well with informers.
+	// See details at https://github.com/kubernetes/kubernetes/issues/95372
+	vol, err := lhClient.LonghornV1beta2().Volumes(TestNamespace).Create(context.TODO(), vol, metav1.CreateOptions{})
+	c.Assert(err, IsNil)
+	c.Assert(volumeIndexer.Add(vol), IsNil)
+	engine, err = lhClient.LonghornV1beta2().Engines(TestNamespace).Create(context.TODO(), engine, metav1.CreateOptions{})
+	c.Assert(err, IsNil)
+	c.Assert(engineIndexer.Add(engine), IsNil)
+	snapshot, err = lhClient.LonghornV1beta2().Snapshots(TestNamespace).Create(context.TODO(), snapshot, metav1.CreateOptions{})
+	c.Assert(err, IsNil)
+	c.Assert(snapshotIndexer.Add(snapshot), IsNil)
+	va, err = lhClient.LonghornV1beta2().VolumeAttachments(TestNamespace).Create(context.TODO(), va, metav1.CreateOptions{})
+	c.Assert(err, IsNil)
+	c.Assert(volumeAttachmentIndexer.Add(va), IsNil)
+
+	err = sc.reconcile(snapshot.Name)
+	c.Assert(err, IsNil)
+
+	retVA, err := lhClient.LonghornV1beta2().VolumeAttachments(TestNamespace).Get(context.TODO(), va.Name, metav1.GetOptions{})
+	c.Assert(err, IsNil)
+	_, ok := retVA.Spec.AttachmentTickets[attachmentTicketID]
+	c.Assert(ok, Equals, false)
+
+	retSnapshot, err := lhClient.LonghornV1beta2().Snapshots(TestNamespace).Get(context.TODO(), snapshot.Name, metav1.GetOptions{})
+	c.Assert(err, IsNil)
+	c.Assert(retSnapshot.Finalizers, DeepEquals, []string{longhorn.SchemeGroupVersion.Group})
+	c.Assert(retSnapshot.Status.Error, Equals, unsupportedErr.Error())
+}
@@ -3,6 +3,8 @@ package datastore
 import (
 	"time"
 
+	"github.com/sirupsen/logrus"
+
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/client-go/informers"
 	clientset "k8s.io/client-go/kubernetes"
@@ -74,6 +76,7 @@ type DataStore struct {
 	oLister                        lhlisters.OrphanLister
 	OrphanInformer                 cache.SharedInformer
 	snapLister                     lhlisters.SnapshotLister
+	snapIndexer                    cache.Indexer
 	SnapshotInformer               cache.SharedInformer
 	supportBundleLister            lhlisters.SupportBundleLister
 	SupportBundleInformer          cache.SharedInformer
@@ -164,6 +167,11 @@ func NewDataStore(
 	cacheSyncs = append(cacheSyncs, oInformer.Informer().HasSynced)
 	snapInformer := lhInformerFactory.Longhorn().V1beta2().Snapshots()
 	cacheSyncs = append(cacheSyncs, snapInformer.Informer().HasSynced)
+	if err := snapInformer.Informer().AddIndexers(cache.Indexers{
+		snapshotLabelIndex: snapshotLabelIndexFunc,
+	}); err != nil {
+		logrus.WithError(err).Warn("Failed to add snapshot label indexer")
+	}
 	supportBundleInformer := lhInformerFactory.Longhorn().V1beta2().SupportBundles()
 	cacheSyncs = append(cacheSyncs, supportBundleInformer.Informer().HasSynced)
 	systemBackupInformer := lhInformerFactory.Longhorn().V1beta2().SystemBackups()
@@ -243,6 +251,7 @@ func NewDataStore(
 		oLister:                        oInformer.Lister(),
 		OrphanInformer:                 oInformer.Informer(),
 		snapLister:                     snapInformer.Lister(),
+		snapIndexer:                    snapInformer.Informer().GetIndexer(),
 		SnapshotInformer:               snapInformer.Informer(),
 		supportBundleLister:            supportBundleInformer.Lister(),
 		SupportBundleInformer:          supportBundleInformer.Informer(),
@@ -19,6 +19,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/selection"
 	"k8s.io/apimachinery/pkg/util/validation"
 
 	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
@@ -31,6 +32,8 @@ const (
 	NameMaximumLength = 40
 
 	MaxRecurringJobRetain = 100
+
+	snapshotLabelIndex = "snapshotLabel"
 )
 
 var (
@@ -3669,6 +3672,92 @@ func (s *DataStore) ListVolumeSnapshotsRO(volumeName string) (map[string]*longho
 	return s.ListSnapshotsRO(selector)
 }
 
+// ListSnapshotsByLabelSelectorRO returns the snapshots of all volumes whose
+// snapshot labels match the given selector. If the selector has an equality
+// requirement, the candidates are looked up in the snapshot label index
+// instead of scanning all snapshots.
+func (s *DataStore) ListSnapshotsByLabelSelectorRO(selector labels.Selector) (map[string]*longhorn.Snapshot, error) {
+	candidates, err := s.listSnapshotCandidatesRO(selector)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots := make(map[string]*longhorn.Snapshot)
+	for _, snap := range candidates {
+		if selector.Matches(getSnapshotLabels(snap)) {
+			snapshots[snap.Name] = snap
+		}
+	}
+	return snapshots, nil
+}
+
+// ListVolumeSnapshotsByLabelSelectorRO returns the snapshots of the volume
+// whose snapshot labels match the given selector.
+func (s *DataStore) ListVolumeSnapshotsByLabelSelectorRO(volumeName string, selector labels.Selector) (map[string]*longhorn.Snapshot, error) {
+	snapshots, err := s.ListSnapshotsByLabelSelectorRO(selector)
+	if err != nil {
+		return nil, err
+	}
+	for name, snap := range snapshots {
+		if snap.Spec.Volume != volumeName {
+			delete(snapshots, name)
+		}
+	}
+	return snapshots, nil
+}
+
+func (s *DataStore) listSnapshotCandidatesRO(selector labels.Selector) ([]*longhorn.Snapshot, error) {
+	requirements, _ := selector.Requirements()
+	for _, r := range requirements {
+		if r.Operator() != selection.Equals && r.Operator() != selection.DoubleEquals {
+			continue
+		}
+		value, _ := r.Values().PopAny()
+		objs, err := s.snapIndexer.ByIndex(snapshotLabelIndex, getSnapshotLabelIndexKey(r.Key(), value))
+		if err != nil {
+			return nil, err
+		}
+		snapshots := []*longhorn.Snapshot{}
+		for _, obj := range objs {
+			snap, ok := obj.(*longhorn.Snapshot)
+			if ok && snap.Namespace == s.namespace {
+				snapshots = append(snapshots, snap)
+			}
+		}
+		return snapshots, nil
+	}
+	return s.snapLister.Snapshots(s.namespace).List(labels.Everything())
+}
+
+// getSnapshotLabels returns the snapshot labels of the snapshot. The labels
+// recorded from the engine take precedence over the requested ones in the spec.
+func getSnapshotLabels(snap *longhorn.Snapshot) labels.Set {
+	snapLabels := labels.Set{}
+	for k, v := range snap.Spec.Labels {
+		snapLabels[k] = v
+	}
+	for k, v := range snap.Status.Labels {
+		snapLabels[k] = v
+	}
+	return snapLabels
+}
+
+func getSnapshotLabelIndexKey(key, value string) string {
+	return key + "=" + value
+}
+
+func snapshotLabelIndexFunc(obj interface{}) ([]string, error) {
+	snap, ok := obj.(*longhorn.Snapshot)
+	if !ok {
+		return []string{}, nil
+	}
+	keys := []string{}
+	for k, v := range getSnapshotLabels(snap) {
+		keys = append(keys, getSnapshotLabelIndexKey(k, v))
+	}
+	return keys, nil
+}
+
 // DeleteSnapshot won't result in immediately deletion since finalizer was set by default
 func (s *DataStore) DeleteSnapshot(snapshotName string) error {
 	return s.lhClient.LonghornV1beta2().Snapshots(s.namespace).Delete(context.TODO(), snapshotName, metav1.DeleteOptions{})
@@ -0,0 +1,114 @@
+package datastore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	apiextensionsfake "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/fake"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+	lhfake "github.com/longhorn/longhorn-manager/k8s/pkg/client/clientset/versioned/fake"
+	lhinformerfactory "github.com/longhorn/longhorn-manager/k8s/pkg/client/informers/externalversions"
+)
+
+const testNamespace = "default"
+
+func newTestSnapshot(name, volumeName string, specLabels, statusLabels map[string]string) *longhorn.Snapshot {
+	return &longhorn.Snapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: testNamespace,
+		},
+		Spec: longhorn.SnapshotSpec{
+			Volume: volumeName,
+			Labels: specLabels,
+		},
+		Status: longhorn.SnapshotStatus{
+			Labels: statusLabels,
+		},
+	}
+}
+
+func TestListSnapshotsByLabelSelectorRO(t *testing.T) {
+	kubeClient := fake.NewSimpleClientset()
+	lhClient := lhfake.NewSimpleClientset()
+	extensionsClient := apiextensionsfake.NewSimpleClientset()
+	kubeInformerFactory := informers.NewSharedInformerFactory(kubeClient, 0)
+	lhInformerFactory := lhinformerfactory.NewSharedInformerFactory(lhClient, 0)
+	ds := NewDataStore(lhInformerFactory, lhClient, kubeInformerFactory, kubeClient, extensionsClient, testNamespace)
+
+	snapshots := []*longhorn.Snapshot{
+		newTestSnapshot("snap-1", "vol-1", map[string]string{"store": "a", "upgrade": "y"}, nil),
+		// The labels recorded from the engine override the ones in the spec
+		newTestSnapshot("snap-2", "vol-2", map[string]string{"store": "a", "upgrade": "y"}, map[string]string{"upgrade": "z"}),
+		newTestSnapshot("snap-3", "vol-1", map[string]string{"store": "b"}, nil),
+	}
+	for _, snap := range snapshots {
+		require.NoError(t, ds.snapIndexer.Add(snap))
+	}
+
+	tests := []struct {
+		name          string
+		volumeName    string
+		selector      string
+		expectedNames []string
+	}{
+		{
+			name:          "equality on spec labels",
+			selector:      "store=a,upgrade=y",
+			expectedNames: []string{"snap-1"},
+		},
+		{
+			name:          "status label overrides spec label",
+			selector:      "upgrade=z",
+			expectedNames: []string{"snap-2"},
+		},
+		{
+			name:          "set based selector",
+			selector:      "store in (a,b)",
+			expectedNames: []string{"snap-1", "snap-2", "snap-3"},
+		},
+		{
+			name:          "inequality",
+			selector:      "store!=b",
+			expectedNames: []string{"snap-1", "snap-2"},
+		},
+		{
+			name:          "volume scoped",
+			volumeName:    "vol-1",
+			selector:      "store",
+			expectedNames: []string{"snap-1", "snap-3"},
+		},
+		{
+			name:          "no match",
+			selector:      "store=c",
+			expectedNames: []string{},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			selector, err := labels.Parse(tc.selector)
+			require.NoError(t, err)
+
+			var result map[string]*longhorn.Snapshot
+			if tc.volumeName != "" {
+				result, err = ds.ListVolumeSnapshotsByLabelSelectorRO(tc.volumeName, selector)
+			} else {
+				result, err = ds.ListSnapshotsByLabelSelectorRO(selector)
+			}
+			require.NoError(t, err)
+
+			names := []string{}
+			for name := range result {
+				names = append(names, name)
+			}
+			require.ElementsMatch(t, tc.expectedNames, names)
+		})
+	}
+}
@@ -1,10 +1,15 @@
 package engineapi
 
 import (
+	"time"
+
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
 
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+
 	imclient "github.com/longhorn/longhorn-instance-manager/pkg/client"
 	imutil "github.com/longhorn/longhorn-instance-manager/pkg/util"
 
@@ -88,9 +93,10 @@ func NewEngineClientProxy(im *longhorn.InstanceManager, logger logrus.FieldLogge
 	proxyConnCounter.IncreaseCount()
 
 	return &Proxy{
-		logger:           logger,
-		grpcClient:       client,
-		proxyConnCounter: proxyConnCounter,
+		logger:              logger,
+		grpcClient:          client,
+		instanceManagerName: im.Name,
+		proxyConnCounter:    proxyConnCounter,
 	}, nil
 }
 
@@ -98,6 +104,8 @@ type Proxy struct {
 	logger     logrus.FieldLogger
 	grpcClient *imclient.ProxyClient
 
+	instanceManagerName string
+
 	proxyConnCounter util.Counter
 }
 
@@ -132,6 +140,26 @@ func (p *Proxy) DirectToURL(e *longhorn.Engine) string {
 	return imutil.GetURL(e.Status.StorageIP, e.Status.Port)
 }
 
+// checkUnsupportedOperation converts the gRPC Unimplemented error returned by
+// the instance manager proxy into an UnsupportedOperationError, so callers can
+// tell an operation missing for the engine backend store driver apart from a
+// real failure.
+func checkUnsupportedOperation(e *longhorn.Engine, operation string, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if grpcstatus.Code(errors.Cause(err)) != grpccodes.Unimplemented {
+		return err
+	}
+
+	return &UnsupportedOperationError{
+		Operation:          operation,
+		EngineName:         e.Name,
+		BackendStoreDriver: e.Spec.BackendStoreDriver,
+	}
+}
+
 func (p *Proxy) VersionGet(e *longhorn.Engine, clientOnly bool) (version *EngineVersion, err error) {
 	recvClientVersion := p.grpcClient.ClientVersionGet()
 	clientVersion := (*longhorn.EngineVersionDetails)(&recvClientVersion)
@@ -142,6 +170,8 @@ func (p *Proxy) VersionGet(e *longhorn.Engine, clientOnly bool) (version *Engine
 		}, nil
 	}
 
+	defer p.observeRequest("VersionGet", time.Now(), &err)
+
 	recvServerVersion, err := p.grpcClient.ServerVersionGet(p.DirectToURL(e))
 	if err != nil {
 		return nil, err
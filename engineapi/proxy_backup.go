@@ -2,6 +2,7 @@ package engineapi
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -13,7 +14,9 @@ import (
 
 func (p *Proxy) SnapshotBackup(e *longhorn.Engine, snapshotName, backupName, backupTarget,
 	backingImageName, backingImageChecksum, compressionMethod string, concurrentLimit int, storageClassName string,
-	labels, credential map[string]string) (string, string, error) {
+	labels, credential map[string]string) (backupID, replicaAddress string, err error) {
+	defer p.observeRequest("SnapshotBackup", time.Now(), &err)
+
 	if snapshotName == etypes.VolumeHeadName {
 		return "", "", fmt.Errorf("invalid operation: cannot backup %v", etypes.VolumeHeadName)
 	}
@@ -37,7 +40,7 @@ func (p *Proxy) SnapshotBackup(e *longhorn.Engine, snapshotName, backupName, bac
 		return "", "", err
 	}
 
-	backupID, replicaAddress, err := p.grpcClient.SnapshotBackup(string(e.Spec.BackendStoreDriver), e.Name, p.DirectToURL(e),
+	backupID, replicaAddress, err = p.grpcClient.SnapshotBackup(string(e.Spec.BackendStoreDriver), e.Name, p.DirectToURL(e),
 		backupName, snapshotName, backupTarget, backingImageName, backingImageChecksum,
 		compressionMethod, concurrentLimit, storageClassName, labels, credentialEnv,
 	)
@@ -49,6 +52,8 @@ func (p *Proxy) SnapshotBackup(e *longhorn.Engine, snapshotName, backupName, bac
 }
 
 func (p *Proxy) SnapshotBackupStatus(e *longhorn.Engine, backupName, replicaAddress string) (status *longhorn.EngineBackupStatus, err error) {
+	defer p.observeRequest("SnapshotBackupStatus", time.Now(), &err)
+
 	recv, err := p.grpcClient.SnapshotBackupStatus(string(e.Spec.BackendStoreDriver), e.Name, p.DirectToURL(e), backupName, replicaAddress)
 	if err != nil {
 		return nil, err
@@ -58,7 +63,9 @@ func (p *Proxy) SnapshotBackupStatus(e *longhorn.Engine, backupName, replicaAddr
 }
 
 func (p *Proxy) BackupRestore(e *longhorn.Engine, backupTarget, backupName, backupVolumeName, lastRestored string,
-	credential map[string]string, concurrentLimit int) error {
+	credential map[string]string, concurrentLimit int) (err error) {
+	defer p.observeRequest("BackupRestore", time.Now(), &err)
+
 	backupURL := backupstore.EncodeBackupURL(backupName, backupVolumeName, backupTarget)
 
 	// get environment variables if backup for s3
@@ -71,6 +78,8 @@ func (p *Proxy) BackupRestore(e *longhorn.Engine, backupTarget, backupName, back
 }
 
 func (p *Proxy) BackupRestoreStatus(e *longhorn.Engine) (status map[string]*longhorn.RestoreStatus, err error) {
+	defer p.observeRequest("BackupRestoreStatus", time.Now(), &err)
+
 	recv, err := p.grpcClient.BackupRestoreStatus(string(e.Spec.BackendStoreDriver), e.Name, p.DirectToURL(e))
 	if err != nil {
 		return nil, err
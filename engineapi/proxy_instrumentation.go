@@ -0,0 +1,54 @@
+package engineapi
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/longhorn/longhorn-manager/metrics_collector/registry"
+)
+
+const (
+	proxyRequestResultSuccess = "success"
+	proxyRequestResultFailure = "failure"
+)
+
+var (
+	proxyRequests = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "longhorn",
+			Subsystem: "engine_proxy",
+			Name:      "requests_total",
+			Help:      "Number of engine proxy requests, partitioned by instance manager, method and result.",
+		},
+		[]string{"instance_manager", "method", "result"},
+	)
+
+	proxyRequestLatency = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "longhorn",
+			Subsystem: "engine_proxy",
+			Name:      "request_latency_seconds",
+			Help:      "Engine proxy request latency in seconds, partitioned by instance manager, method and result.",
+			Buckets:   prometheus.ExponentialBuckets(0.001, 2, 15),
+		},
+		[]string{"instance_manager", "method", "result"},
+	)
+)
+
+func init() {
+	registry.Register(proxyRequests)
+	registry.Register(proxyRequestLatency)
+}
+
+// observeRequest records the result and the latency of an engine proxy
+// request. It is meant to be deferred at the beginning of each Proxy method.
+func (p *Proxy) observeRequest(method string, start time.Time, err *error) {
+	result := proxyRequestResultSuccess
+	if err != nil && *err != nil {
+		result = proxyRequestResultFailure
+	}
+
+	proxyRequests.WithLabelValues(p.instanceManagerName, method, result).Inc()
+	proxyRequestLatency.WithLabelValues(p.instanceManagerName, method, result).Observe(time.Since(start).Seconds())
+}
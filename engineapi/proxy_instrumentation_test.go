@@ -0,0 +1,29 @@
+package engineapi
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestObserveRequest(t *testing.T) {
+	p := &Proxy{instanceManagerName: "test-im-0"}
+
+	succeeded := proxyRequests.WithLabelValues("test-im-0", "SnapshotCreate", proxyRequestResultSuccess)
+	failed := proxyRequests.WithLabelValues("test-im-0", "SnapshotCreate", proxyRequestResultFailure)
+	succeededBefore := testutil.ToFloat64(succeeded)
+	failedBefore := testutil.ToFloat64(failed)
+
+	observe := func(err error) {
+		p.observeRequest("SnapshotCreate", time.Now(), &err)
+	}
+	observe(nil)
+	observe(fmt.Errorf("failed to create snapshot"))
+	observe(fmt.Errorf("failed to create snapshot"))
+
+	require.Equal(t, float64(1), testutil.ToFloat64(succeeded)-succeededBefore)
+	require.Equal(t, float64(2), testutil.ToFloat64(failed)-failedBefore)
+}
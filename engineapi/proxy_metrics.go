@@ -1,13 +1,17 @@
 package engineapi
 
 import (
+	"time"
+
 	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
 )
 
-func (p *Proxy) MetricsGet(e *longhorn.Engine) (*Metrics, error) {
-	metrics, err := p.grpcClient.MetricsGet(p.DirectToURL(e))
+func (p *Proxy) MetricsGet(e *longhorn.Engine) (metrics *Metrics, err error) {
+	defer p.observeRequest("MetricsGet", time.Now(), &err)
+
+	recv, err := p.grpcClient.MetricsGet(p.DirectToURL(e))
 	if err != nil {
 		return nil, err
 	}
-	return (*Metrics)(metrics), err
+	return (*Metrics)(recv), nil
 }
@@ -1,18 +1,26 @@
 package engineapi
 
 import (
+	"time"
+
 	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
 )
 
 func (p *Proxy) ReplicaAdd(e *longhorn.Engine, replicaName, replicaAddress string, restore, fastSync bool, replicaFileSyncHTTPClientTimeout int64) (err error) {
+	defer p.observeRequest("ReplicaAdd", time.Now(), &err)
+
 	return p.grpcClient.ReplicaAdd(string(e.Spec.BackendStoreDriver), e.Name, p.DirectToURL(e), replicaName, replicaAddress, restore, e.Spec.VolumeSize, e.Status.CurrentSize, int(replicaFileSyncHTTPClientTimeout), fastSync)
 }
 
 func (p *Proxy) ReplicaRemove(e *longhorn.Engine, address string) (err error) {
+	defer p.observeRequest("ReplicaRemove", time.Now(), &err)
+
 	return p.grpcClient.ReplicaRemove(string(e.Spec.BackendStoreDriver), p.DirectToURL(e), e.Name, address, "")
 }
 
 func (p *Proxy) ReplicaList(e *longhorn.Engine) (replicas map[string]*Replica, err error) {
+	defer p.observeRequest("ReplicaList", time.Now(), &err)
+
 	resp, err := p.grpcClient.ReplicaList(string(e.Spec.BackendStoreDriver), e.Name, p.DirectToURL(e))
 	if err != nil {
 		return nil, err
@@ -33,6 +41,8 @@ func (p *Proxy) ReplicaList(e *longhorn.Engine) (replicas map[string]*Replica, e
 }
 
 func (p *Proxy) ReplicaRebuildStatus(e *longhorn.Engine) (status map[string]*longhorn.RebuildStatus, err error) {
+	defer p.observeRequest("ReplicaRebuildStatus", time.Now(), &err)
+
 	recv, err := p.grpcClient.ReplicaRebuildingStatus(string(e.Spec.BackendStoreDriver), e.Name, p.DirectToURL(e))
 	if err != nil {
 		return nil, err
@@ -46,6 +56,8 @@ func (p *Proxy) ReplicaRebuildStatus(e *longhorn.Engine) (status map[string]*lon
 }
 
 func (p *Proxy) ReplicaRebuildVerify(e *longhorn.Engine, url string) (err error) {
+	defer p.observeRequest("ReplicaRebuildVerify", time.Now(), &err)
+
 	if err := ValidateReplicaURL(url); err != nil {
 		return err
 	}
@@ -54,6 +66,8 @@ func (p *Proxy) ReplicaRebuildVerify(e *longhorn.Engine, url string) (err error)
 }
 
 func (p *Proxy) ReplicaModeUpdate(e *longhorn.Engine, url, mode string) (err error) {
+	defer p.observeRequest("ReplicaModeUpdate", time.Now(), &err)
+
 	if err := ValidateReplicaURL(url); err != nil {
 		return err
 	}
@@ -1,14 +1,20 @@
 package engineapi
 
 import (
+	"time"
+
 	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
 )
 
-func (p *Proxy) SnapshotCreate(e *longhorn.Engine, name string, labels map[string]string) (string, error) {
+func (p *Proxy) SnapshotCreate(e *longhorn.Engine, name string, labels map[string]string) (snapshotName string, err error) {
+	defer p.observeRequest("SnapshotCreate", time.Now(), &err)
+
 	return p.grpcClient.VolumeSnapshot(string(e.Spec.BackendStoreDriver), e.Name, p.DirectToURL(e), name, labels)
 }
 
 func (p *Proxy) SnapshotList(e *longhorn.Engine) (snapshots map[string]*longhorn.SnapshotInfo, err error) {
+	defer p.observeRequest("SnapshotList", time.Now(), &err)
+
 	recv, err := p.grpcClient.SnapshotList(string(e.Spec.BackendStoreDriver), e.Name, p.DirectToURL(e))
 	if err != nil {
 		return nil, err
@@ -31,10 +37,15 @@ func (p *Proxy) SnapshotGet(e *longhorn.Engine, name string) (snapshot *longhorn
 }
 
 func (p *Proxy) SnapshotClone(e *longhorn.Engine, snapshotName, fromController string, fileSyncHTTPClientTimeout int64) (err error) {
-	return p.grpcClient.SnapshotClone(string(e.Spec.BackendStoreDriver), e.Name, p.DirectToURL(e), snapshotName, fromController, int(fileSyncHTTPClientTimeout))
+	defer p.observeRequest("SnapshotClone", time.Now(), &err)
+
+	err = p.grpcClient.SnapshotClone(string(e.Spec.BackendStoreDriver), e.Name, p.DirectToURL(e), snapshotName, fromController, int(fileSyncHTTPClientTimeout))
+	return checkUnsupportedOperation(e, OperationSnapshotClone, err)
 }
 
 func (p *Proxy) SnapshotCloneStatus(e *longhorn.Engine) (status map[string]*longhorn.SnapshotCloneStatus, err error) {
+	defer p.observeRequest("SnapshotCloneStatus", time.Now(), &err)
+
 	recv, err := p.grpcClient.SnapshotCloneStatus(string(e.Spec.BackendStoreDriver), e.Name, p.DirectToURL(e))
 	if err != nil {
 		return nil, err
@@ -48,14 +59,22 @@ func (p *Proxy) SnapshotCloneStatus(e *longhorn.Engine) (status map[string]*long
 }
 
 func (p *Proxy) SnapshotRevert(e *longhorn.Engine, snapshotName string) (err error) {
-	return p.grpcClient.SnapshotRevert(string(e.Spec.BackendStoreDriver), e.Name, p.DirectToURL(e), snapshotName)
+	defer p.observeRequest("SnapshotRevert", time.Now(), &err)
+
+	err = p.grpcClient.SnapshotRevert(string(e.Spec.BackendStoreDriver), e.Name, p.DirectToURL(e), snapshotName)
+	return checkUnsupportedOperation(e, OperationSnapshotRevert, err)
 }
 
 func (p *Proxy) SnapshotPurge(e *longhorn.Engine) (err error) {
-	return p.grpcClient.SnapshotPurge(string(e.Spec.BackendStoreDriver), e.Name, p.DirectToURL(e), true)
+	defer p.observeRequest("SnapshotPurge", time.Now(), &err)
+
+	err = p.grpcClient.SnapshotPurge(string(e.Spec.BackendStoreDriver), e.Name, p.DirectToURL(e), true)
+	return checkUnsupportedOperation(e, OperationSnapshotPurge, err)
 }
 
 func (p *Proxy) SnapshotPurgeStatus(e *longhorn.Engine) (status map[string]*longhorn.PurgeStatus, err error) {
+	defer p.observeRequest("SnapshotPurgeStatus", time.Now(), &err)
+
 	recv, err := p.grpcClient.SnapshotPurgeStatus(string(e.Spec.BackendStoreDriver), e.Name, p.DirectToURL(e))
 	if err != nil {
 		return nil, err
@@ -69,14 +88,21 @@ func (p *Proxy) SnapshotPurgeStatus(e *longhorn.Engine) (status map[string]*long
 }
 
 func (p *Proxy) SnapshotDelete(e *longhorn.Engine, name string) (err error) {
-	return p.grpcClient.SnapshotRemove(string(e.Spec.BackendStoreDriver), e.Name, p.DirectToURL(e), []string{name})
+	defer p.observeRequest("SnapshotDelete", time.Now(), &err)
+
+	err = p.grpcClient.SnapshotRemove(string(e.Spec.BackendStoreDriver), e.Name, p.DirectToURL(e), []string{name})
+	return checkUnsupportedOperation(e, OperationSnapshotDelete, err)
 }
 
-func (p *Proxy) SnapshotHash(e *longhorn.Engine, snapshotName string, rehash bool) error {
+func (p *Proxy) SnapshotHash(e *longhorn.Engine, snapshotName string, rehash bool) (err error) {
+	defer p.observeRequest("SnapshotHash", time.Now(), &err)
+
 	return p.grpcClient.SnapshotHash(string(e.Spec.BackendStoreDriver), e.Name, p.DirectToURL(e), snapshotName, rehash)
 }
 
 func (p *Proxy) SnapshotHashStatus(e *longhorn.Engine, snapshotName string) (status map[string]*longhorn.HashStatus, err error) {
+	defer p.observeRequest("SnapshotHashStatus", time.Now(), &err)
+
 	recv, err := p.grpcClient.SnapshotHashStatus(string(e.Spec.BackendStoreDriver), e.Name, p.DirectToURL(e), snapshotName)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,59 @@
+package engineapi
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+func TestCheckUnsupportedOperation(t *testing.T) {
+	engine := &longhorn.Engine{}
+	engine.Name = "test-e-0"
+	engine.Spec.BackendStoreDriver = longhorn.BackendStoreDriverTypeV2
+
+	tests := []struct {
+		name              string
+		err               error
+		expectNil         bool
+		expectUnsupported bool
+	}{
+		{
+			name:      "no error",
+			err:       nil,
+			expectNil: true,
+		},
+		{
+			name:              "unimplemented",
+			err:               errors.Wrap(grpcstatus.Error(grpccodes.Unimplemented, "not implemented"), "failed to revert volume to snapshot"),
+			expectUnsupported: true,
+		},
+		{
+			name: "other gRPC error",
+			err:  errors.Wrap(grpcstatus.Error(grpccodes.Internal, "internal error"), "failed to revert volume to snapshot"),
+		},
+		{
+			name: "non gRPC error",
+			err:  fmt.Errorf("failed to revert volume to snapshot"),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := checkUnsupportedOperation(engine, OperationSnapshotRevert, tc.err)
+			if tc.expectNil {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			require.Equal(t, tc.expectUnsupported, IsUnsupportedOperationError(err))
+			require.Equal(t, tc.expectUnsupported, IsUnsupportedOperationError(errors.Wrap(err, "wrapped")))
+		})
+	}
+}
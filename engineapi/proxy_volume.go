@@ -2,11 +2,14 @@ package engineapi
 
 import (
 	"fmt"
+	"time"
 
 	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
 )
 
 func (p *Proxy) VolumeGet(e *longhorn.Engine) (volume *Volume, err error) {
+	defer p.observeRequest("VolumeGet", time.Now(), &err)
+
 	recv, err := p.grpcClient.VolumeGet(string(e.Spec.BackendStoreDriver), e.Name, p.DirectToURL(e))
 	if err != nil {
 		return nil, err
@@ -16,10 +19,14 @@ func (p *Proxy) VolumeGet(e *longhorn.Engine) (volume *Volume, err error) {
 }
 
 func (p *Proxy) VolumeExpand(e *longhorn.Engine) (err error) {
+	defer p.observeRequest("VolumeExpand", time.Now(), &err)
+
 	return p.grpcClient.VolumeExpand(string(e.Spec.BackendStoreDriver), e.Name, p.DirectToURL(e), e.Spec.VolumeSize)
 }
 
 func (p *Proxy) VolumeFrontendStart(e *longhorn.Engine) (err error) {
+	defer p.observeRequest("VolumeFrontendStart", time.Now(), &err)
+
 	frontendName, err := GetEngineInstanceFrontend(e.Spec.BackendStoreDriver, e.Spec.Frontend)
 	if err != nil {
 		return err
@@ -33,9 +40,13 @@ func (p *Proxy) VolumeFrontendStart(e *longhorn.Engine) (err error) {
 }
 
 func (p *Proxy) VolumeFrontendShutdown(e *longhorn.Engine) (err error) {
+	defer p.observeRequest("VolumeFrontendShutdown", time.Now(), &err)
+
 	return p.grpcClient.VolumeFrontendShutdown(string(e.Spec.BackendStoreDriver), e.Name, p.DirectToURL(e))
 }
 
-func (p *Proxy) VolumeUnmapMarkSnapChainRemovedSet(e *longhorn.Engine) error {
+func (p *Proxy) VolumeUnmapMarkSnapChainRemovedSet(e *longhorn.Engine) (err error) {
+	defer p.observeRequest("VolumeUnmapMarkSnapChainRemovedSet", time.Now(), &err)
+
 	return p.grpcClient.VolumeUnmapMarkSnapChainRemovedSet(string(e.Spec.BackendStoreDriver), e.Name, p.DirectToURL(e), e.Spec.UnmapMarkSnapChainRemovedEnabled)
 }
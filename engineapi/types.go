@@ -5,6 +5,8 @@ import (
 	"strings"
 	"time"
 
+	"github.com/pkg/errors"
+
 	iscsidevtypes "github.com/longhorn/go-iscsi-helper/types"
 	spdkdevtypes "github.com/longhorn/go-spdk-helper/pkg/types"
 
@@ -296,3 +298,31 @@ func IsEndpointTGTBlockDev(endpoint string) bool {
 
 	return true
 }
+
+const (
+	OperationSnapshotClone  = "snapshot clone"
+	OperationSnapshotRevert = "snapshot revert"
+	OperationSnapshotPurge  = "snapshot purge"
+	OperationSnapshotDelete = "snapshot delete"
+)
+
+// UnsupportedOperationError indicates the backend store driver of the engine
+// does not implement the requested operation yet. It is returned by the proxy
+// SnapshotClone, SnapshotRevert, SnapshotPurge and SnapshotDelete. Only the
+// snapshot controller records it, in Snapshot.Status.Error when deleting a
+// snapshot, and stops retrying the deletion for the same engine. The other
+// callers pass it up as a regular error.
+type UnsupportedOperationError struct {
+	Operation          string
+	EngineName         string
+	BackendStoreDriver longhorn.BackendStoreDriverType
+}
+
+func (e *UnsupportedOperationError) Error() string {
+	return fmt.Sprintf("%v is not supported for engine %v with backend store driver %v", e.Operation, e.EngineName, e.BackendStoreDriver)
+}
+
+func IsUnsupportedOperationError(err error) bool {
+	_, ok := errors.Cause(err).(*UnsupportedOperationError)
+	return ok
+}
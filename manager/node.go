@@ -2,15 +2,30 @@ package manager
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 
+	"github.com/longhorn/longhorn-manager/datastore"
 	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
 	"github.com/longhorn/longhorn-manager/types"
 	"github.com/longhorn/longhorn-manager/util"
 )
 
+// VolumeNodeImpact describes how a volume is affected when a node becomes
+// unavailable, e.g. cordoned and drained or rebooted.
+type VolumeNodeImpact struct {
+	VolumeName                  string
+	State                       longhorn.VolumeState
+	Robustness                  longhorn.VolumeRobustness
+	AttachedToNode              bool
+	ReplicasOnNode              int
+	HealthyReplicasOnOtherNodes int
+	CanFailover                 bool
+	Reason                      string
+}
+
 func (m *VolumeManager) GetInstanceManager(name string) (*longhorn.InstanceManager, error) {
 	return m.ds.GetInstanceManager(name)
 }
@@ -117,6 +132,108 @@ func (m *VolumeManager) DiskUpdate(name string, updateDisks map[string]longhorn.
 	return node, nil
 }
 
+// GetNodeImpact returns the volumes that would be affected if the node becomes
+// unavailable, and whether each of them can keep serving or be moved to
+// another node without the data on this node. A volume is considered attached
+// to the node if it is requested or running there, if it is being migrated to
+// or from the node, or, for RWX volumes, if its share manager pod runs there.
+// Only active and healthy replicas that are not being deleted count for
+// failover.
+func (m *VolumeManager) GetNodeImpact(name string) ([]*VolumeNodeImpact, error) {
+	if _, err := m.ds.GetNodeRO(name); err != nil {
+		return nil, err
+	}
+
+	volumes, err := m.ds.ListVolumesRO()
+	if err != nil {
+		return nil, err
+	}
+
+	replicas, err := m.ds.ListReplicasRO()
+	if err != nil {
+		return nil, err
+	}
+	volumeReplicas := map[string][]*longhorn.Replica{}
+	for _, r := range replicas {
+		volumeReplicas[r.Spec.VolumeName] = append(volumeReplicas[r.Spec.VolumeName], r)
+	}
+
+	smPods, err := m.ds.ListShareManagerPods()
+	if err != nil {
+		return nil, err
+	}
+	shareManagerNodes := map[string]string{}
+	for _, pod := range smPods {
+		shareManagerNodes[types.GetShareManagerNameFromShareManagerPodName(pod.Name)] = pod.Spec.NodeName
+	}
+
+	impacts := []*VolumeNodeImpact{}
+	for _, v := range volumes {
+		// The share manager is named after the volume
+		if impact := getVolumeNodeImpact(v, volumeReplicas[v.Name], shareManagerNodes[v.Name], name); impact != nil {
+			impacts = append(impacts, impact)
+		}
+	}
+
+	sort.Slice(impacts, func(i, j int) bool {
+		return impacts[i].VolumeName < impacts[j].VolumeName
+	})
+	return impacts, nil
+}
+
+// getVolumeNodeImpact returns how the volume is affected if the node becomes
+// unavailable, or nil if the volume neither is attached to the node nor has
+// replicas on it.
+func getVolumeNodeImpact(v *longhorn.Volume, replicas []*longhorn.Replica, shareManagerNodeID, name string) *VolumeNodeImpact {
+	isRWX := v.Spec.AccessMode == longhorn.AccessModeReadWriteMany
+	hasShareManagerOnNode := isRWX && shareManagerNodeID == name
+	isMigratingOnNode := v.Spec.MigrationNodeID != "" &&
+		(v.Spec.MigrationNodeID == name || v.Spec.NodeID == name || v.Status.CurrentNodeID == name)
+
+	impact := &VolumeNodeImpact{
+		VolumeName: v.Name,
+		State:      v.Status.State,
+		Robustness: v.Status.Robustness,
+		AttachedToNode: v.Spec.NodeID == name || v.Status.CurrentNodeID == name ||
+			isMigratingOnNode || hasShareManagerOnNode,
+	}
+	for _, r := range replicas {
+		if r.Spec.NodeID == name {
+			impact.ReplicasOnNode++
+			continue
+		}
+		if datastore.IsAvailableHealthyReplica(r) && r.Spec.Active {
+			impact.HealthyReplicasOnOtherNodes++
+		}
+	}
+
+	if !impact.AttachedToNode && impact.ReplicasOnNode == 0 {
+		return nil
+	}
+
+	switch {
+	case impact.HealthyReplicasOnOtherNodes == 0:
+		impact.Reason = "no healthy replica on other nodes"
+	case isMigratingOnNode:
+		impact.Reason = "volume is being migrated to or from the node"
+	case impact.AttachedToNode && isRWX:
+		// The share manager pod is recreated on another node, and the
+		// workloads reconnect to it.
+		impact.CanFailover = true
+		impact.Reason = "share manager of the volume runs on the node and will be moved"
+	case impact.AttachedToNode:
+		// The workload using the volume has to be rescheduled before the
+		// volume can be attached to another node.
+		impact.CanFailover = true
+		impact.Reason = "volume is attached to the node and the workload needs to be moved"
+	default:
+		impact.CanFailover = true
+		impact.Reason = "volume is not attached to the node and has healthy replicas on other nodes"
+	}
+
+	return impact
+}
+
 func (m *VolumeManager) DeleteNode(name string) error {
 	node, err := m.ds.GetNode(name)
 	if err != nil {
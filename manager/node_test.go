@@ -0,0 +1,172 @@
+package manager
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
+)
+
+const (
+	testNode1 = "test-node-1"
+	testNode2 = "test-node-2"
+	testNode3 = "test-node-3"
+)
+
+func newTestReplica(nodeID string, healthy, active bool) *longhorn.Replica {
+	r := &longhorn.Replica{}
+	r.Spec.NodeID = nodeID
+	r.Spec.Active = active
+	if healthy {
+		r.Spec.HealthyAt = "2023-01-01T00:00:00Z"
+	}
+	return r
+}
+
+func TestGetVolumeNodeImpact(t *testing.T) {
+	deletingReplica := newTestReplica(testNode2, true, true)
+	now := metav1.Now()
+	deletingReplica.DeletionTimestamp = &now
+
+	failedReplica := newTestReplica(testNode2, true, true)
+	failedReplica.Spec.FailedAt = "2023-01-01T00:00:00Z"
+
+	tests := []struct {
+		name               string
+		accessMode         longhorn.AccessMode
+		nodeID             string
+		currentNodeID      string
+		migrationNodeID    string
+		shareManagerNodeID string
+		replicas           []*longhorn.Replica
+		expectNil          bool
+		expectAttached     bool
+		expectHealthy      int
+		expectCanFailover  bool
+		expectReason       string
+	}{
+		{
+			name:      "not attached and no replica on the node",
+			nodeID:    testNode2,
+			replicas:  []*longhorn.Replica{newTestReplica(testNode2, true, true)},
+			expectNil: true,
+		},
+		{
+			name:              "detached with healthy replica elsewhere",
+			replicas:          []*longhorn.Replica{newTestReplica(testNode1, true, true), newTestReplica(testNode2, true, true)},
+			expectHealthy:     1,
+			expectCanFailover: true,
+			expectReason:      "volume is not attached to the node and has healthy replicas on other nodes",
+		},
+		{
+			name:              "attached RWO volume",
+			nodeID:            testNode1,
+			currentNodeID:     testNode1,
+			replicas:          []*longhorn.Replica{newTestReplica(testNode2, true, true)},
+			expectAttached:    true,
+			expectHealthy:     1,
+			expectCanFailover: true,
+			expectReason:      "volume is attached to the node and the workload needs to be moved",
+		},
+		{
+			name:              "attaching RWO volume",
+			nodeID:            testNode1,
+			replicas:          []*longhorn.Replica{newTestReplica(testNode2, true, true)},
+			expectAttached:    true,
+			expectHealthy:     1,
+			expectCanFailover: true,
+			expectReason:      "volume is attached to the node and the workload needs to be moved",
+		},
+		{
+			name:               "RWX volume with share manager pod on the node",
+			accessMode:         longhorn.AccessModeReadWriteMany,
+			nodeID:             testNode2,
+			currentNodeID:      testNode2,
+			shareManagerNodeID: testNode1,
+			replicas:           []*longhorn.Replica{newTestReplica(testNode2, true, true)},
+			expectAttached:     true,
+			expectHealthy:      1,
+			expectCanFailover:  true,
+			expectReason:       "share manager of the volume runs on the node and will be moved",
+		},
+		{
+			name:               "RWX volume with share manager pod on another node",
+			accessMode:         longhorn.AccessModeReadWriteMany,
+			nodeID:             testNode2,
+			currentNodeID:      testNode2,
+			shareManagerNodeID: testNode2,
+			replicas:           []*longhorn.Replica{newTestReplica(testNode2, true, true)},
+			expectNil:          true,
+		},
+		{
+			name:            "migrating to the node",
+			nodeID:          testNode2,
+			currentNodeID:   testNode2,
+			migrationNodeID: testNode1,
+			replicas:        []*longhorn.Replica{newTestReplica(testNode2, true, true)},
+			expectAttached:  true,
+			expectHealthy:   1,
+			expectReason:    "volume is being migrated to or from the node",
+		},
+		{
+			name:            "migrating off the node",
+			nodeID:          testNode1,
+			currentNodeID:   testNode1,
+			migrationNodeID: testNode2,
+			replicas:        []*longhorn.Replica{newTestReplica(testNode2, true, true)},
+			expectAttached:  true,
+			expectHealthy:   1,
+			expectReason:    "volume is being migrated to or from the node",
+		},
+		{
+			name:            "migration between other nodes",
+			nodeID:          testNode2,
+			currentNodeID:   testNode2,
+			migrationNodeID: testNode3,
+			replicas:        []*longhorn.Replica{newTestReplica(testNode2, true, true)},
+			expectNil:       true,
+		},
+		{
+			name:          "only inactive, failed, deleting or unhealthy replicas elsewhere",
+			nodeID:        testNode1,
+			currentNodeID: testNode1,
+			replicas: []*longhorn.Replica{
+				newTestReplica(testNode1, true, true),
+				newTestReplica(testNode2, true, false),
+				newTestReplica(testNode2, false, true),
+				failedReplica,
+				deletingReplica,
+			},
+			expectAttached: true,
+			expectReason:   "no healthy replica on other nodes",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			v := &longhorn.Volume{}
+			v.Name = "test-volume"
+			v.Spec.AccessMode = tc.accessMode
+			v.Spec.NodeID = tc.nodeID
+			v.Spec.MigrationNodeID = tc.migrationNodeID
+			v.Status.CurrentNodeID = tc.currentNodeID
+			for _, r := range tc.replicas {
+				r.Spec.VolumeName = v.Name
+			}
+
+			impact := getVolumeNodeImpact(v, tc.replicas, tc.shareManagerNodeID, testNode1)
+			if tc.expectNil {
+				require.Nil(t, impact)
+				return
+			}
+			require.NotNil(t, impact)
+			require.Equal(t, tc.expectAttached, impact.AttachedToNode)
+			require.Equal(t, tc.expectHealthy, impact.HealthyReplicasOnOtherNodes)
+			require.Equal(t, tc.expectCanFailover, impact.CanFailover)
+			require.Equal(t, tc.expectReason, impact.Reason)
+		})
+	}
+}
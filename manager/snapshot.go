@@ -3,9 +3,11 @@ package manager
 import (
 	"fmt"
 
+	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 
 	bsutil "github.com/longhorn/backupstore/util"
 	longhorn "github.com/longhorn/longhorn-manager/k8s/pkg/apis/longhorn/v1beta2"
@@ -15,6 +17,14 @@ func (m *VolumeManager) ListSnapshotsCR(volumeName string) (map[string]*longhorn
 	return m.ds.ListVolumeSnapshotsRO(volumeName)
 }
 
+func (m *VolumeManager) ListSnapshotsCRByLabelSelector(volumeName, labelSelector string) (map[string]*longhorn.Snapshot, error) {
+	selector, err := labels.Parse(labelSelector)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid label selector %v", labelSelector)
+	}
+	return m.ds.ListVolumeSnapshotsByLabelSelectorRO(volumeName, selector)
+}
+
 func (m *VolumeManager) GetSnapshotCR(snapName string) (*longhorn.Snapshot, error) {
 	return m.ds.GetSnapshotRO(snapName)
 }